@@ -0,0 +1,374 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// QueueFullPolicy controls what a BatchingExporter does with a record that
+// arrives while its queue is at QueueSize.
+type QueueFullPolicy int
+
+const (
+	// DropNewest discards the record that triggered the overflow, keeping
+	// everything already queued.
+	DropNewest QueueFullPolicy = iota
+
+	// DropOldest discards the oldest queued batch to make room.
+	DropOldest
+
+	// Block waits for room in the queue, applying backpressure to the
+	// caller of Export.
+	Block
+)
+
+// RetryConfig controls how a BatchingExporter retries a batch that failed
+// with a transient error.
+type RetryConfig struct {
+	// MaxRetries is the number of additional attempts made after the first.
+	// A value of 0 disables retries.
+	MaxRetries int
+
+	// InitialBackoff is the base delay before the first retry. Each actual
+	// delay is jittered to a random value between half and all of the base.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the base delay between retries.
+	MaxBackoff time.Duration
+
+	// Multiplier scales InitialBackoff after each retry. A value <= 1 is
+	// treated as 2.
+	Multiplier float64
+}
+
+// BatchConfig configures a BatchingExporter.
+type BatchConfig struct {
+	// QueueSize is the maximum number of records held in memory awaiting
+	// export. A value <= 0 is treated as 2048.
+	QueueSize int
+
+	// NumWorkers is the number of goroutines draining the queue
+	// concurrently. A value <= 0 is treated as 1.
+	NumWorkers int
+
+	// MaxExportBatchSize is the maximum number of records sent to the inner
+	// Exporter in a single Export call.
+	MaxExportBatchSize int
+
+	// ExportTimeout bounds each call to the inner Exporter, including
+	// retries. A value <= 0 means no timeout.
+	ExportTimeout time.Duration
+
+	// Retry configures the backoff applied to transient writer errors.
+	Retry RetryConfig
+
+	// QueueFullPolicy selects what happens when the queue is full.
+	QueueFullPolicy QueueFullPolicy
+}
+
+// Stats is a point-in-time snapshot of a BatchingExporter's counters.
+type Stats struct {
+	Enqueued uint64
+	Dropped  uint64
+	Retried  uint64
+	Exported uint64
+}
+
+// BatchingExporter buffers records in a bounded queue and exports them to an
+// inner Exporter from a pool of workers, retrying transient writer errors
+// with exponential backoff and jitter.
+type BatchingExporter struct {
+	inner *Exporter
+	cfg   BatchConfig
+
+	mu            sync.Mutex
+	queue         [][]sdklog.Record
+	queuedRecords int
+	inFlight      int
+	draining      bool
+	// notify is closed, and replaced with a fresh channel, every time state
+	// that a blocked Export/dequeue/ForceFlush cares about changes. Waiters
+	// hold a reference to the channel they are waiting on, not b itself, so
+	// they can select on ctx.Done() alongside it.
+	notify chan struct{}
+
+	stats Stats
+
+	workersWG sync.WaitGroup
+	stopOnce  sync.Once
+}
+
+// NewBatchingExporter wraps inner with a bounded queue and worker pool. Call
+// Export on the returned BatchingExporter instead of inner.
+func NewBatchingExporter(inner *Exporter, cfg BatchConfig) *BatchingExporter {
+	if cfg.NumWorkers <= 0 {
+		cfg.NumWorkers = 1
+	}
+	if cfg.MaxExportBatchSize <= 0 {
+		cfg.MaxExportBatchSize = 512
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 2048
+	}
+	if cfg.Retry.Multiplier <= 1 {
+		cfg.Retry.Multiplier = 2
+	}
+
+	b := &BatchingExporter{
+		inner:  inner,
+		cfg:    cfg,
+		notify: make(chan struct{}),
+	}
+
+	b.workersWG.Add(cfg.NumWorkers)
+	for i := 0; i < cfg.NumWorkers; i++ {
+		go b.work()
+	}
+
+	return b
+}
+
+// wakeLocked notifies anything blocked in Export, dequeue, or ForceFlush
+// that state has changed. It must be called with b.mu held.
+func (b *BatchingExporter) wakeLocked() {
+	close(b.notify)
+	b.notify = make(chan struct{})
+}
+
+// Export enqueues records for export by a worker. Depending on
+// BatchConfig.QueueFullPolicy, Export may drop records or block if the
+// queue is full. Blocking respects ctx's deadline/cancellation.
+func (b *BatchingExporter) Export(ctx context.Context, records []sdklog.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for {
+		b.mu.Lock()
+
+		if b.draining {
+			b.mu.Unlock()
+			return nil
+		}
+
+		fits := b.queuedRecords+len(records) <= b.cfg.QueueSize
+		tooBigToEverFit := len(records) > b.cfg.QueueSize
+
+		if fits {
+			cp := make([]sdklog.Record, len(records))
+			copy(cp, records)
+			b.queue = append(b.queue, cp)
+			b.queuedRecords += len(records)
+			atomic.AddUint64(&b.stats.Enqueued, uint64(len(records)))
+			b.wakeLocked()
+			b.mu.Unlock()
+			return nil
+		}
+
+		if tooBigToEverFit {
+			atomic.AddUint64(&b.stats.Dropped, uint64(len(records)))
+			b.mu.Unlock()
+			return nil
+		}
+
+		switch b.cfg.QueueFullPolicy {
+		case DropOldest:
+			oldest := b.queue[0]
+			b.queue = b.queue[1:]
+			b.queuedRecords -= len(oldest)
+			atomic.AddUint64(&b.stats.Dropped, uint64(len(oldest)))
+			b.mu.Unlock()
+			// loop back around to re-check whether there's now room
+
+		case Block:
+			waitCh := b.notify
+			b.mu.Unlock()
+			select {
+			case <-waitCh:
+				// loop back around to re-check whether there's now room
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+		default: // DropNewest
+			atomic.AddUint64(&b.stats.Dropped, uint64(len(records)))
+			b.mu.Unlock()
+			return nil
+		}
+	}
+}
+
+func (b *BatchingExporter) work() {
+	defer b.workersWG.Done()
+
+	for {
+		batch, ok := b.dequeue()
+		if !ok {
+			return
+		}
+		b.exportWithRetry(batch)
+	}
+}
+
+// dequeue waits for and removes the next batch from the queue, marking its
+// records in flight. It returns ok == false once the queue is draining and
+// empty.
+func (b *BatchingExporter) dequeue() ([]sdklog.Record, bool) {
+	for {
+		b.mu.Lock()
+		if len(b.queue) > 0 {
+			batch := b.queue[0]
+			b.queue = b.queue[1:]
+			b.queuedRecords -= len(batch)
+			b.inFlight += len(batch)
+			b.wakeLocked()
+			b.mu.Unlock()
+			return batch, true
+		}
+		if b.draining {
+			b.mu.Unlock()
+			return nil, false
+		}
+		waitCh := b.notify
+		b.mu.Unlock()
+		<-waitCh
+	}
+}
+
+// finishInFlight marks n records as no longer in flight and wakes anything
+// waiting in ForceFlush or Shutdown for the queue to fully drain.
+func (b *BatchingExporter) finishInFlight(n int) {
+	b.mu.Lock()
+	b.inFlight -= n
+	b.wakeLocked()
+	b.mu.Unlock()
+}
+
+func (b *BatchingExporter) exportWithRetry(records []sdklog.Record) {
+	for start := 0; start < len(records); start += b.cfg.MaxExportBatchSize {
+		end := start + b.cfg.MaxExportBatchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		b.exportChunkWithRetry(records[start:end])
+	}
+}
+
+func (b *BatchingExporter) exportChunkWithRetry(chunk []sdklog.Record) {
+	defer b.finishInFlight(len(chunk))
+
+	ctx := context.Background()
+	var cancel context.CancelFunc
+	if b.cfg.ExportTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, b.cfg.ExportTimeout)
+		defer cancel()
+	}
+
+	backoff := b.cfg.Retry.InitialBackoff
+
+	for attempt := 0; ; attempt++ {
+		err := b.inner.Export(ctx, chunk)
+		if err == nil {
+			atomic.AddUint64(&b.stats.Exported, uint64(len(chunk)))
+			return
+		}
+		if attempt >= b.cfg.Retry.MaxRetries || !isRetryable(err) {
+			// Permanently failed: the chunk is lost. Count it as dropped so
+			// Stats() stays a faithful account of every enqueued record.
+			atomic.AddUint64(&b.stats.Dropped, uint64(len(chunk)))
+			return
+		}
+
+		atomic.AddUint64(&b.stats.Retried, uint64(len(chunk)))
+
+		sleep := backoff
+		if b.cfg.Retry.MaxBackoff > 0 && sleep > b.cfg.Retry.MaxBackoff {
+			sleep = b.cfg.Retry.MaxBackoff
+		}
+		if sleep > 0 {
+			jitter := time.Duration(rand.Int63n(int64(sleep)/2 + 1))
+			time.Sleep(sleep/2 + jitter)
+		}
+		backoff = time.Duration(float64(backoff) * b.cfg.Retry.Multiplier)
+	}
+}
+
+// isRetryable reports whether err looks like a transient writer error worth
+// retrying, e.g. a short write or a *os.PathError surfaced by a rotating
+// file mid-rename.
+func isRetryable(err error) bool {
+	if errors.Is(err, io.ErrShortWrite) {
+		return true
+	}
+	var pathErr *os.PathError
+	return errors.As(err, &pathErr)
+}
+
+// ForceFlush blocks until every record enqueued before the call returns has
+// either been exported or permanently failed, or ctx is done.
+func (b *BatchingExporter) ForceFlush(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		if len(b.queue) == 0 && b.inFlight == 0 {
+			b.mu.Unlock()
+			return b.inner.ForceFlush(ctx)
+		}
+		waitCh := b.notify
+		b.mu.Unlock()
+
+		select {
+		case <-waitCh:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Shutdown stops accepting new records, drains the queue, joins the
+// workers, and shuts down the inner Exporter.
+func (b *BatchingExporter) Shutdown(ctx context.Context) error {
+	b.stopOnce.Do(func() {
+		b.mu.Lock()
+		b.draining = true
+		b.wakeLocked()
+		b.mu.Unlock()
+	})
+
+	done := make(chan struct{})
+	go func() {
+		b.workersWG.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return b.inner.Shutdown(ctx)
+}
+
+// Stats returns a snapshot of the BatchingExporter's counters.
+func (b *BatchingExporter) Stats() Stats {
+	return Stats{
+		Enqueued: atomic.LoadUint64(&b.stats.Enqueued),
+		Dropped:  atomic.LoadUint64(&b.stats.Dropped),
+		Retried:  atomic.LoadUint64(&b.stats.Retried),
+		Exported: atomic.LoadUint64(&b.stats.Exported),
+	}
+}
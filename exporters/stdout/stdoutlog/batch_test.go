@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+func TestBatchingExporterConcurrentNoLoss(t *testing.T) {
+	var buf bytes.Buffer
+	var mu sync.Mutex
+
+	inner, err := New(WithWriter(&lockedWriter{w: &buf, mu: &mu}))
+	require.NoError(t, err)
+
+	b := NewBatchingExporter(inner, BatchConfig{
+		QueueSize:          16,
+		NumWorkers:         4,
+		MaxExportBatchSize: 8,
+		QueueFullPolicy:    Block,
+	})
+
+	const goroutines = 20
+	const perGoroutine = 25
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				err := b.Export(context.Background(), []sdklog.Record{{}})
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, b.ForceFlush(ctx))
+	require.NoError(t, b.Shutdown(ctx))
+
+	stats := b.Stats()
+	assert.Equal(t, uint64(goroutines*perGoroutine), stats.Enqueued)
+	assert.Equal(t, uint64(goroutines*perGoroutine), stats.Exported)
+	assert.Equal(t, uint64(0), stats.Dropped)
+}
+
+// lockedWriter serializes writes so the test can safely share a bytes.Buffer
+// across the BatchingExporter's workers.
+type lockedWriter struct {
+	w  *bytes.Buffer
+	mu *sync.Mutex
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
+
+func (l *lockedWriter) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.String()
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"bufio"
+	"io"
+	"sync"
+	"time"
+)
+
+// WithBufferedWriter wraps w so that writes are buffered and flushed either
+// once size bytes have accumulated or every flushInterval, whichever comes
+// first. The buffer is also drained by Exporter.ForceFlush and
+// Exporter.Shutdown.
+func WithBufferedWriter(w io.Writer, size int, flushInterval time.Duration) Option {
+	return WithWriter(newBufferedWriter(w, size, flushInterval))
+}
+
+// bufferedWriter is an io.Writer that batches writes to an underlying
+// writer, flushing on a timer driven by a single goroutine.
+type bufferedWriter struct {
+	mu  sync.Mutex
+	buf *bufio.Writer
+
+	done chan struct{}
+	once sync.Once
+}
+
+func newBufferedWriter(w io.Writer, size int, flushInterval time.Duration) *bufferedWriter {
+	bw := &bufferedWriter{
+		buf:  bufio.NewWriterSize(w, size),
+		done: make(chan struct{}),
+	}
+
+	if flushInterval > 0 {
+		go bw.flushLoop(flushInterval)
+	}
+
+	return bw
+}
+
+func (bw *bufferedWriter) flushLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			bw.Flush()
+		case <-bw.done:
+			return
+		}
+	}
+}
+
+func (bw *bufferedWriter) Write(p []byte) (int, error) {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+	return bw.buf.Write(p)
+}
+
+// Flush writes any buffered data to the underlying writer. It implements the
+// interface Exporter.ForceFlush looks for.
+func (bw *bufferedWriter) Flush() error {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+	return bw.buf.Flush()
+}
+
+// Close stops the flush timer and performs a final flush. It implements the
+// interface Exporter.Shutdown looks for.
+func (bw *bufferedWriter) Close() error {
+	bw.once.Do(func() { close(bw.done) })
+	return bw.Flush()
+}
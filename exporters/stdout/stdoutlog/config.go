@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"io"
+	"os"
+)
+
+// defaultWriter is the default destination for an Exporter created without
+// WithWriter. It is a package variable, rather than a constant, so tests can
+// swap it out.
+var defaultWriter io.Writer = os.Stdout
+
+const defaultTimestamps = true
+
+// config contains options for the stdoutlog.Exporter.
+type config struct {
+	Writer      io.Writer
+	PrettyPrint bool
+	Timestamps  bool
+	Encoding    Encoding
+	Sink        Sink
+	Processors  []processor
+}
+
+// newConfig creates a config struct with default values, applying all
+// Options in order.
+func newConfig(options []Option) config {
+	cfg := config{
+		Writer:     defaultWriter,
+		Timestamps: defaultTimestamps,
+		Encoding:   EncodingLegacyJSON,
+	}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option sets the configuration value for an Exporter.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithWriter sets the export stream destination.
+func WithWriter(w io.Writer) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.Writer = w
+		return cfg
+	})
+}
+
+// WithPrettyPrint sets the exporter to use JSON pretty-print format for
+// exported log records. It has no effect with WithEncoding(EncodingOTLPProtobuf).
+func WithPrettyPrint() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.PrettyPrint = true
+		return cfg
+	})
+}
+
+// WithoutTimestamps sets the exporter to not print timestamps.
+func WithoutTimestamps() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.Timestamps = false
+		return cfg
+	})
+}
+
+// WithEncoding sets the wire format the Exporter serializes records to. The
+// default is EncodingLegacyJSON.
+func WithEncoding(encoding Encoding) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.Encoding = encoding
+		return cfg
+	})
+}
@@ -0,0 +1,13 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stdoutlog provides an exporter for the OpenTelemetry log SDK that
+// writes records to an io.Writer, a structured-logging sink, or a rotating
+// file.
+//
+// By default the exporter serializes each batch of log.Records as one JSON
+// object per line, using the exporter's own legacy field layout
+// (EncodingLegacyJSON). Use WithEncoding to emit the official OTLP wire
+// format instead, which is understood by collector receivers such as
+// otlpjsonfile without a bespoke parser.
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+// Encoding selects the wire format the Exporter uses to serialize
+// log.Records.
+type Encoding int
+
+const (
+	// EncodingLegacyJSON serializes each record as a standalone JSON object,
+	// one per line, using the exporter's own ad-hoc field layout. This is
+	// the default and is kept for backwards compatibility.
+	EncodingLegacyJSON Encoding = iota
+
+	// EncodingOTLPJSON serializes each exported batch as a single line of
+	// OTLP/JSON, matching the
+	// opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest schema
+	// (resourceLogs -> scopeLogs -> logRecords). The output is consumable by
+	// the OTel Collector's otlpjsonfile receiver.
+	EncodingOTLPJSON
+
+	// EncodingOTLPProtobuf serializes each exported batch as a single
+	// length-delimited OTLP/Protobuf frame: a 4-byte big-endian length
+	// prefix followed by the marshaled
+	// opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest.
+	EncodingOTLPProtobuf
+)
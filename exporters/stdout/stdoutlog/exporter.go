@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog/internal/transform"
+)
+
+// Exporter writes log.Records to an io.Writer as either legacy JSON lines or
+// OTLP (JSON or length-delimited Protobuf), depending on how it was
+// configured with New.
+type Exporter struct {
+	encoderMu sync.Mutex
+	encoder   *json.Encoder
+
+	timestamps  bool
+	prettyPrint bool
+	encoding    Encoding
+	writer      io.Writer
+	sink        Sink
+	processors  []processor
+
+	stoppedMu sync.RWMutex
+	stopped   bool
+}
+
+// New creates an Exporter. By default it writes EncodingLegacyJSON to
+// os.Stdout; use the Options to change the writer, encoding, or formatting,
+// or to fan records out to a structured-logging sink with WithSlogHandler or
+// WithZapCore instead.
+func New(options ...Option) (*Exporter, error) {
+	cfg := newConfig(options)
+
+	enc := json.NewEncoder(cfg.Writer)
+	if cfg.PrettyPrint {
+		enc.SetIndent("", "\t")
+	}
+
+	return &Exporter{
+		encoder:     enc,
+		timestamps:  cfg.Timestamps,
+		prettyPrint: cfg.PrettyPrint,
+		encoding:    cfg.Encoding,
+		writer:      cfg.Writer,
+		sink:        cfg.Sink,
+		processors:  cfg.Processors,
+	}, nil
+}
+
+// Export exports log records to the configured writer.
+func (e *Exporter) Export(ctx context.Context, records []sdklog.Record) error {
+	if e == nil || e.encoder == nil {
+		return nil
+	}
+
+	e.stoppedMu.RLock()
+	stopped := e.stopped
+	e.stoppedMu.RUnlock()
+	if stopped {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	if len(e.processors) > 0 {
+		records = e.applyProcessors(records)
+		if len(records) == 0 {
+			return nil
+		}
+	}
+
+	if e.sink != nil {
+		return e.exportSink(records)
+	}
+
+	switch e.encoding {
+	case EncodingOTLPJSON:
+		return e.exportOTLPJSON(records)
+	case EncodingOTLPProtobuf:
+		return e.exportOTLPProtobuf(records)
+	default:
+		return e.exportLegacyJSON(records)
+	}
+}
+
+// applyProcessors runs every registered processor, in registration order,
+// over each record and returns the records that survive.
+func (e *Exporter) applyProcessors(records []sdklog.Record) []sdklog.Record {
+	kept := records[:0]
+	for _, record := range records {
+		ok := true
+		for _, p := range e.processors {
+			record, ok = p.Process(record)
+			if !ok {
+				break
+			}
+		}
+		if ok {
+			kept = append(kept, record)
+		}
+	}
+	return kept
+}
+
+func (e *Exporter) exportSink(records []sdklog.Record) error {
+	for _, record := range records {
+		if err := e.sink.Emit(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportLegacyJSON(records []sdklog.Record) error {
+	e.encoderMu.Lock()
+	defer e.encoderMu.Unlock()
+
+	for _, record := range records {
+		if err := e.encoder.Encode(newRecordJSON(record, e.timestamps)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportOTLPJSON(records []sdklog.Record) error {
+	req := transform.ExportLogsServiceRequest(records, e.timestamps)
+
+	b, err := transform.MarshalJSON(req, e.prettyPrint)
+	if err != nil {
+		return fmt.Errorf("stdoutlog: marshal OTLP/JSON: %w", err)
+	}
+	b = append(b, '\n')
+
+	e.encoderMu.Lock()
+	defer e.encoderMu.Unlock()
+
+	_, err = e.writer.Write(b)
+	return err
+}
+
+func (e *Exporter) exportOTLPProtobuf(records []sdklog.Record) error {
+	req := transform.ExportLogsServiceRequest(records, e.timestamps)
+
+	b, err := transform.MarshalProto(req)
+	if err != nil {
+		return fmt.Errorf("stdoutlog: marshal OTLP/Protobuf: %w", err)
+	}
+
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(b)))
+
+	e.encoderMu.Lock()
+	defer e.encoderMu.Unlock()
+
+	if _, err := e.writer.Write(header[:]); err != nil {
+		return err
+	}
+	_, err = e.writer.Write(b)
+	return err
+}
+
+// flusher is implemented by writers, such as the one returned from
+// WithBufferedWriter, that buffer data and need an explicit flush.
+type flusher interface {
+	Flush() error
+}
+
+// ForceFlush flushes any buffered log records, such as those held by a
+// WithBufferedWriter writer.
+func (e *Exporter) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if f, ok := e.writer.(flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Shutdown shuts down the Exporter. Calls to Export made after Shutdown will
+// return nil without writing anything. If the configured writer implements
+// io.Closer, such as the ones returned from WithRotatingFile or
+// WithBufferedWriter, it is closed. The default os.Stdout writer is never
+// closed, since doing so would take stdout away from the rest of the process.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	e.stoppedMu.Lock()
+	e.stopped = true
+	e.stoppedMu.Unlock()
+
+	if e.writer != os.Stdout && e.writer != os.Stderr {
+		if c, ok := e.writer.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// processor rewrites or drops a record before it reaches the writer or
+// sink. Processors run in registration order inside Export.
+type processor interface {
+	// Process returns the (possibly modified) record and whether it should
+	// still be exported.
+	Process(record sdklog.Record) (sdklog.Record, bool)
+}
+
+// WithFilter adds a processor that drops any record for which keep returns
+// false. Filters run in registration order before serialization.
+func WithFilter(keep func(record sdklog.Record) bool) Option {
+	return addProcessor(filterFunc(keep))
+}
+
+// WithMinSeverity is a shortcut for WithFilter that drops records below min.
+func WithMinSeverity(min log.Severity) Option {
+	return WithFilter(func(record sdklog.Record) bool {
+		return record.Severity() >= min
+	})
+}
+
+// WithAttributeRedactor adds a processor that runs redact over every
+// attribute on a record. Returning false drops the attribute entirely.
+func WithAttributeRedactor(redact func(log.KeyValue) (log.KeyValue, bool)) Option {
+	return addProcessor(attributeRedactor(redact))
+}
+
+// WithBodyRedactor adds a processor that rewrites a record's body with
+// redact, e.g. to scrub PII with a regular expression.
+func WithBodyRedactor(redact func(log.Value) log.Value) Option {
+	return addProcessor(bodyRedactor(redact))
+}
+
+func addProcessor(p processor) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.Processors = append(cfg.Processors, p)
+		return cfg
+	})
+}
+
+type filterFunc func(sdklog.Record) bool
+
+func (f filterFunc) Process(record sdklog.Record) (sdklog.Record, bool) {
+	return record, f(record)
+}
+
+type attributeRedactor func(log.KeyValue) (log.KeyValue, bool)
+
+func (f attributeRedactor) Process(record sdklog.Record) (sdklog.Record, bool) {
+	kept := make([]log.KeyValue, 0, record.AttributesLen())
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		if redacted, ok := f(kv); ok {
+			kept = append(kept, redacted)
+		}
+		return true
+	})
+	record.SetAttributes(kept...)
+	return record, true
+}
+
+type bodyRedactor func(log.Value) log.Value
+
+func (f bodyRedactor) Process(record sdklog.Record) (sdklog.Record, bool) {
+	record.SetBody(f(record.Body()))
+	return record, true
+}
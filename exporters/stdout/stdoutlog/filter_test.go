@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// recordingSink captures every record it is asked to Emit, so tests can
+// inspect what a processor did to a record without round-tripping it
+// through the legacy JSON encoding (whose Value fields are opaque).
+type recordingSink struct {
+	records []sdklog.Record
+}
+
+func (s *recordingSink) Emit(record sdklog.Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func newRecord(sev log.Severity, body string, attrs ...log.KeyValue) sdklog.Record {
+	r := sdklog.Record{}
+	r.SetSeverity(sev)
+	r.SetBody(log.StringValue(body))
+	r.SetAttributes(attrs...)
+	return r
+}
+
+func TestWithMinSeverityDropsBelowThreshold(t *testing.T) {
+	sink := &recordingSink{}
+	exporter, err := New(WithSink(sink), WithMinSeverity(log.SeverityWarn1))
+	require.NoError(t, err)
+
+	records := []sdklog.Record{
+		newRecord(log.SeverityInfo1, "dropped"),
+		newRecord(log.SeverityWarn1, "kept"),
+	}
+	require.NoError(t, exporter.Export(context.Background(), records))
+
+	require.Len(t, sink.records, 1)
+	assert.Equal(t, "kept", sink.records[0].Body().AsString())
+}
+
+func TestWithAttributeAndBodyRedactor(t *testing.T) {
+	sink := &recordingSink{}
+	exporter, err := New(
+		WithSink(sink),
+		WithAttributeRedactor(func(kv log.KeyValue) (log.KeyValue, bool) {
+			if kv.Key == "drop-me" {
+				return kv, false
+			}
+			return kv, true
+		}),
+		WithBodyRedactor(func(log.Value) log.Value {
+			return log.StringValue("redacted")
+		}),
+	)
+	require.NoError(t, err)
+
+	record := newRecord(log.SeverityInfo1, "secret",
+		log.String("keep-me", "value"),
+		log.String("drop-me", "value"),
+	)
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	require.Len(t, sink.records, 1)
+	got := sink.records[0]
+	assert.Equal(t, "redacted", got.Body().AsString())
+
+	require.Equal(t, 1, got.AttributesLen(), "drop-me should have been removed by the attribute redactor")
+	got.WalkAttributes(func(kv log.KeyValue) bool {
+		assert.Equal(t, "keep-me", kv.Key)
+		return true
+	})
+}
+
+func TestWithBuiltinRedactorsCreditCard(t *testing.T) {
+	sink := &recordingSink{}
+	exporter, err := New(WithSink(sink), WithBuiltinRedactors(RedactCreditCards))
+	require.NoError(t, err)
+
+	record := newRecord(log.SeverityInfo1, "card 4111 1111 1111 1111 on file")
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	require.Len(t, sink.records, 1)
+	assert.Equal(t, "card [REDACTED] on file", sink.records[0].Body().AsString())
+}
@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package transform converts the SDK's log.Record representation into the
+// OTLP wire types used by EncodingOTLPJSON and EncodingOTLPProtobuf.
+package transform // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog/internal/transform"
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	collectorlogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// ExportLogsServiceRequest groups records by their Resource and
+// InstrumentationScope and returns the resulting
+// collector.logs.v1.ExportLogsServiceRequest. If timestamps is false,
+// TimeUnixNano and ObservedTimeUnixNano are omitted from every LogRecord.
+func ExportLogsServiceRequest(records []sdklog.Record, timestamps bool) *collectorlogspb.ExportLogsServiceRequest {
+	type scopeKey struct {
+		name, version, schemaURL string
+	}
+
+	resources := make(map[attribute.Distinct]*resource.Resource)
+	scopesByResource := make(map[attribute.Distinct]map[scopeKey][]*logspb.LogRecord)
+
+	for _, record := range records {
+		res := record.Resource()
+		resKey := res.Equivalent()
+		if _, ok := resources[resKey]; !ok {
+			resources[resKey] = res
+			scopesByResource[resKey] = make(map[scopeKey][]*logspb.LogRecord)
+		}
+
+		scope := record.InstrumentationScope()
+		sKey := scopeKey{scope.Name, scope.Version, scope.SchemaURL}
+		scopesByResource[resKey][sKey] = append(scopesByResource[resKey][sKey], logRecord(record, timestamps))
+	}
+
+	req := &collectorlogspb.ExportLogsServiceRequest{}
+	for resKey, res := range resources {
+		rl := &logspb.ResourceLogs{Resource: resourceProto(res)}
+		for sKey, recs := range scopesByResource[resKey] {
+			rl.ScopeLogs = append(rl.ScopeLogs, &logspb.ScopeLogs{
+				Scope: &commonpb.InstrumentationScope{
+					Name:    sKey.name,
+					Version: sKey.version,
+				},
+				SchemaUrl:  sKey.schemaURL,
+				LogRecords: recs,
+			})
+		}
+		req.ResourceLogs = append(req.ResourceLogs, rl)
+	}
+	return req
+}
+
+// MarshalProto marshals req using the binary protobuf wire format.
+func MarshalProto(req *collectorlogspb.ExportLogsServiceRequest) ([]byte, error) {
+	return proto.Marshal(req)
+}
+
+// MarshalJSON marshals req using the protobuf JSON mapping, rather than
+// encoding/json over the generated Go struct, so the output matches the
+// opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest schema
+// (e.g. oneof AnyValue fields are flattened instead of appearing as a
+// nested "Value" object). If prettyPrint is true, the output is indented
+// for human readability instead of compact.
+func MarshalJSON(req *collectorlogspb.ExportLogsServiceRequest, prettyPrint bool) ([]byte, error) {
+	opts := protojson.MarshalOptions{}
+	if prettyPrint {
+		opts.Multiline = true
+		opts.Indent = "\t"
+	}
+	return opts.Marshal(req)
+}
+
+func logRecord(record sdklog.Record, timestamps bool) *logspb.LogRecord {
+	attrs := make([]*commonpb.KeyValue, 0, record.AttributesLen())
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		attrs = append(attrs, keyValue(kv))
+		return true
+	})
+
+	traceID := record.TraceID()
+	spanID := record.SpanID()
+
+	rec := &logspb.LogRecord{
+		SeverityNumber: logspb.SeverityNumber(record.Severity()),
+		SeverityText:   record.SeverityText(),
+		Body:           value(record.Body()),
+		Attributes:     attrs,
+		Flags:          uint32(record.TraceFlags()),
+		TraceId:        traceID[:],
+		SpanId:         spanID[:],
+	}
+	if timestamps {
+		rec.TimeUnixNano = uint64(record.Timestamp().UnixNano())
+		rec.ObservedTimeUnixNano = uint64(record.ObservedTimestamp().UnixNano())
+	}
+	return rec
+}
+
+func resourceProto(res *resource.Resource) *resourcepb.Resource {
+	if res == nil {
+		return &resourcepb.Resource{}
+	}
+	attrs := make([]*commonpb.KeyValue, 0, res.Len())
+	iter := res.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		attrs = append(attrs, &commonpb.KeyValue{
+			Key:   string(kv.Key),
+			Value: resourceAttributeValue(kv.Value),
+		})
+	}
+	return &resourcepb.Resource{Attributes: attrs}
+}
+
+// resourceAttributeValue converts an attribute.Value, which only supports
+// the scalar/slice kinds used for resource attributes, to its OTLP wire
+// representation.
+func resourceAttributeValue(v attribute.Value) *commonpb.AnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v.AsBool()}}
+	case attribute.INT64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v.AsInt64()}}
+	case attribute.FLOAT64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v.AsFloat64()}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.Emit()}}
+	}
+}
+
+func keyValue(kv log.KeyValue) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   kv.Key,
+		Value: value(kv.Value),
+	}
+}
+
+func value(v log.Value) *commonpb.AnyValue {
+	switch v.Kind() {
+	case log.KindBool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v.AsBool()}}
+	case log.KindFloat64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v.AsFloat64()}}
+	case log.KindInt64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v.AsInt64()}}
+	case log.KindString:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.AsString()}}
+	case log.KindBytes:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: v.AsBytes()}}
+	case log.KindSlice:
+		vals := make([]*commonpb.AnyValue, 0, len(v.AsSlice()))
+		for _, e := range v.AsSlice() {
+			vals = append(vals, value(e))
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: vals}}}
+	case log.KindMap:
+		kvs := make([]*commonpb.KeyValue, 0, len(v.AsMap()))
+		for _, e := range v.AsMap() {
+			kvs = append(kvs, keyValue(e))
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{Values: kvs}}}
+	default:
+		return &commonpb.AnyValue{}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stdoutlogrus provides a go.opentelemetry.io/otel/exporters/stdout/stdoutlog
+// Sink that fans records out to a *logrus.Logger. It has its own go.mod,
+// separate from stdoutlog's, so that importing the exporter does not pull in
+// logrus for users who don't need it.
+package stdoutlogrus // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog/logrus"
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// WithLogrusLogger returns a stdoutlog.Option that configures the Exporter
+// to emit records to logger instead of serializing them onto a Writer.
+func WithLogrusLogger(logger *logrus.Logger) stdoutlog.Option {
+	return stdoutlog.WithSink(&sink{logger: logger})
+}
+
+type sink struct {
+	logger *logrus.Logger
+}
+
+func (s *sink) Emit(record sdklog.Record) error {
+	fields := make(logrus.Fields, record.AttributesLen()+4)
+
+	if traceID := record.TraceID(); traceID.IsValid() {
+		fields["TraceID"] = traceID.String()
+	}
+	if spanID := record.SpanID(); spanID.IsValid() {
+		fields["SpanID"] = spanID.String()
+	}
+	if flags := record.TraceFlags(); flags != 0 {
+		fields["TraceFlags"] = flags.String()
+	}
+
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		fields[kv.Key] = nativeValue(kv.Value)
+		return true
+	})
+
+	scope := record.InstrumentationScope()
+	fields["Scope"] = logrus.Fields{"Name": scope.Name, "Version": scope.Version}
+
+	if res := record.Resource(); res.Len() > 0 {
+		resAttrs := make(logrus.Fields, res.Len())
+		iter := res.Iter()
+		for iter.Next() {
+			kv := iter.Attribute()
+			resAttrs[string(kv.Key)] = kv.Value.AsInterface()
+		}
+		fields["Resource"] = resAttrs
+	}
+
+	s.logger.WithFields(fields).WithTime(record.Timestamp()).Log(logrusLevel(record.Severity()), record.Body().AsString())
+	return nil
+}
+
+// logrusLevel maps an OTel log.Severity to the nearest logrus.Level.
+func logrusLevel(sev log.Severity) logrus.Level {
+	switch {
+	case sev >= log.SeverityFatal1:
+		return logrus.FatalLevel
+	case sev >= log.SeverityError1:
+		return logrus.ErrorLevel
+	case sev >= log.SeverityWarn1:
+		return logrus.WarnLevel
+	case sev >= log.SeverityInfo1:
+		return logrus.InfoLevel
+	default:
+		return logrus.DebugLevel
+	}
+}
+
+// nativeValue converts a log.Value into a plain Go value so it can be
+// attached as a logrus field, flattening slice/map values.
+func nativeValue(v log.Value) any {
+	switch v.Kind() {
+	case log.KindBool:
+		return v.AsBool()
+	case log.KindFloat64:
+		return v.AsFloat64()
+	case log.KindInt64:
+		return v.AsInt64()
+	case log.KindString:
+		return v.AsString()
+	case log.KindBytes:
+		return v.AsBytes()
+	case log.KindSlice:
+		s := v.AsSlice()
+		out := make([]any, len(s))
+		for i, e := range s {
+			out[i] = nativeValue(e)
+		}
+		return out
+	case log.KindMap:
+		m := v.AsMap()
+		out := make(logrus.Fields, len(m))
+		for _, kv := range m {
+			out[kv.Key] = nativeValue(kv.Value)
+		}
+		return out
+	default:
+		return v.String()
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlogrus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLogrusSinkEmit(t *testing.T) {
+	logger, hook := logrustest.NewNullLogger()
+	logger.SetLevel(logrus.InfoLevel)
+
+	exporter, err := stdoutlog.New(WithLogrusLogger(logger))
+	require.NoError(t, err)
+
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+
+	record := sdklog.Record{}
+	record.SetSeverity(log.SeverityWarn1)
+	record.SetBody(log.StringValue("warn entry"))
+	record.SetAttributes(log.Int64("count", 3), log.Bool("sampled", true))
+	record.SetTraceID(traceID)
+	record.SetSpanID(spanID)
+	record.SetTraceFlags(trace.FlagsSampled)
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	dropped := sdklog.Record{}
+	dropped.SetSeverity(log.SeverityDebug1)
+	dropped.SetBody(log.StringValue("debug entry"))
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{dropped}))
+
+	entries := hook.AllEntries()
+	require.Len(t, entries, 1, "the debug entry should have been dropped by the logger's level")
+
+	entry := entries[0]
+	assert.Equal(t, logrus.WarnLevel, entry.Level)
+	assert.Equal(t, "warn entry", entry.Message)
+	assert.Equal(t, int64(3), entry.Data["count"])
+	assert.Equal(t, true, entry.Data["sampled"])
+	assert.Equal(t, traceID.String(), entry.Data["TraceID"])
+	assert.Equal(t, spanID.String(), entry.Data["SpanID"])
+	assert.Equal(t, trace.FlagsSampled.String(), entry.Data["TraceFlags"])
+	assert.Equal(t, logrus.Fields{"Name": "", "Version": ""}, entry.Data["Scope"])
+	assert.NotContains(t, entry.Data, "Resource", "empty resource should not add a field")
+}
+
+func TestLogrusLevelMapping(t *testing.T) {
+	testCases := []struct {
+		severity log.Severity
+		want     logrus.Level
+	}{
+		{log.SeverityDebug1, logrus.DebugLevel},
+		{log.SeverityInfo1, logrus.InfoLevel},
+		{log.SeverityWarn1, logrus.WarnLevel},
+		{log.SeverityError1, logrus.ErrorLevel},
+		{log.SeverityFatal1, logrus.FatalLevel},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, logrusLevel(tc.severity))
+	}
+}
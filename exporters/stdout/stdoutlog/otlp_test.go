@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	collectorlogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+func TestExporterExportOTLPJSONRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	exporter, err := New(WithWriter(&buf), WithEncoding(EncodingOTLPJSON))
+	require.NoError(t, err)
+
+	record := getRecord(time.Now())
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	got := &collectorlogspb.ExportLogsServiceRequest{}
+	require.NoError(t, protojson.Unmarshal(bytes.TrimRight(buf.Bytes(), "\n"), got))
+	require.Len(t, got.ResourceLogs, 1)
+	require.Len(t, got.ResourceLogs[0].ScopeLogs, 1)
+	require.Len(t, got.ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+
+	gotRecord := got.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	require.Equal(t, "INFO", gotRecord.SeverityText)
+	require.Len(t, gotRecord.Attributes, 6)
+}
+
+func TestExporterExportOTLPProtobufRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	exporter, err := New(WithWriter(&buf), WithEncoding(EncodingOTLPProtobuf))
+	require.NoError(t, err)
+
+	record := getRecord(time.Now())
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	var length uint32
+	require.NoError(t, binary.Read(&buf, binary.BigEndian, &length))
+	require.EqualValues(t, buf.Len(), length)
+
+	got := &collectorlogspb.ExportLogsServiceRequest{}
+	require.NoError(t, proto.Unmarshal(buf.Bytes(), got))
+	require.Len(t, got.ResourceLogs, 1)
+	require.Len(t, got.ResourceLogs[0].ScopeLogs, 1)
+	require.Len(t, got.ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+	require.Equal(t, "INFO", got.ResourceLogs[0].ScopeLogs[0].LogRecords[0].SeverityText)
+}
+
+func TestExporterExportOTLPJSONWithoutTimestamps(t *testing.T) {
+	var buf bytes.Buffer
+	exporter, err := New(WithWriter(&buf), WithEncoding(EncodingOTLPJSON), WithoutTimestamps())
+	require.NoError(t, err)
+
+	record := getRecord(time.Now())
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	got := &collectorlogspb.ExportLogsServiceRequest{}
+	require.NoError(t, protojson.Unmarshal(bytes.TrimRight(buf.Bytes(), "\n"), got))
+	require.Len(t, got.ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+
+	gotRecord := got.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	require.Zero(t, gotRecord.TimeUnixNano)
+	require.Zero(t, gotRecord.ObservedTimeUnixNano)
+}
+
+func TestExporterExportOTLPJSONPrettyPrint(t *testing.T) {
+	var buf bytes.Buffer
+	exporter, err := New(WithWriter(&buf), WithEncoding(EncodingOTLPJSON), WithPrettyPrint())
+	require.NoError(t, err)
+
+	record := getRecord(time.Now())
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	require.Contains(t, buf.String(), "\n\t", "pretty-printed JSON should be indented across multiple lines")
+
+	got := &collectorlogspb.ExportLogsServiceRequest{}
+	require.NoError(t, protojson.Unmarshal(bytes.TrimRight(buf.Bytes(), "\n"), got))
+	require.Len(t, got.ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+}
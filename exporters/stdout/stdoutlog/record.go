@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// scopeJSON is the legacy, exporter-specific JSON shape for an
+// instrumentation scope. It is defined locally, rather than reusing
+// instrumentation.Scope directly, so that fields added to that type in the
+// future don't change this exporter's wire format.
+type scopeJSON struct {
+	Name      string
+	Version   string
+	SchemaURL string
+}
+
+// recordJSON is the legacy, exporter-specific JSON shape for a sdklog.Record.
+// It is kept for backwards compatibility; see EncodingOTLPJSON and
+// EncodingOTLPProtobuf for the standard OTLP wire formats.
+type recordJSON struct {
+	Timestamp         time.Time
+	ObservedTimestamp time.Time
+	Severity          log.Severity
+	SeverityText      string
+	Body              log.Value
+
+	Attributes []log.KeyValue
+
+	TraceID    string
+	SpanID     string
+	TraceFlags string
+
+	// Resource is a value, not a pointer, so that the struct value passed to
+	// json.Encoder.Encode stays unaddressable and falls back to the default
+	// reflection-based encoding instead of resource.Resource's pointer-receiver
+	// MarshalJSON; resource.Resource has no exported fields, so this keeps the
+	// historical "{}" shape regardless of the record's attributes.
+	Resource resource.Resource
+	Scope    scopeJSON
+
+	AttributeValueLengthLimit int
+	AttributeCountLimit       int
+}
+
+// newRecordJSON converts record into its legacy JSON representation. If
+// timestamps is false, Timestamp and ObservedTimestamp are zeroed.
+func newRecordJSON(record sdklog.Record, timestamps bool) recordJSON {
+	attrs := make([]log.KeyValue, 0, record.AttributesLen())
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		attrs = append(attrs, kv)
+		return true
+	})
+
+	var ts, ots time.Time
+	if timestamps {
+		ts = record.Timestamp()
+		ots = record.ObservedTimestamp()
+	}
+
+	traceID := record.TraceID()
+	spanID := record.SpanID()
+
+	// record.Resource() is nil for a zero-value Record, so only dereference it
+	// when present; the zero value of resource.Resource already encodes as {}.
+	var res resource.Resource
+	if r := record.Resource(); r != nil {
+		res = *r
+	}
+
+	scope := record.InstrumentationScope()
+
+	return recordJSON{
+		Timestamp:         ts,
+		ObservedTimestamp: ots,
+		Severity:          record.Severity(),
+		SeverityText:      record.SeverityText(),
+		Body:              record.Body(),
+
+		Attributes: attrs,
+
+		TraceID:    traceID.String(),
+		SpanID:     spanID.String(),
+		TraceFlags: record.TraceFlags().String(),
+
+		Resource: res,
+		Scope: scopeJSON{
+			Name:      scope.Name,
+			Version:   scope.Version,
+			SchemaURL: scope.SchemaURL,
+		},
+
+		// sdklog.Record does not expose its attribute value-length/count
+		// limits, so these are left at their zero value.
+	}
+}
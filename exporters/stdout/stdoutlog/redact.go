@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// RedactorSet selects which built-in redactors WithBuiltinRedactors installs.
+// Values can be combined with a bitwise OR.
+type RedactorSet int
+
+const (
+	// RedactCreditCards masks runs of 13-19 digits, optionally separated by
+	// spaces or dashes, that look like a credit card number.
+	RedactCreditCards RedactorSet = 1 << iota
+
+	// RedactAuthorizationHeaders masks the rest of the line following
+	// "Authorization:" (scheme and credentials) in the body and in string
+	// attribute values, and the whole value of an attribute whose key is
+	// "authorization" (case-insensitive).
+	RedactAuthorizationHeaders
+
+	// RedactEmails masks email addresses.
+	RedactEmails
+
+	// RedactAll enables every built-in redactor.
+	RedactAll = RedactCreditCards | RedactAuthorizationHeaders | RedactEmails
+)
+
+const redactedPlaceholder = "[REDACTED]"
+
+var (
+	creditCardPattern = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	authHeaderPattern = regexp.MustCompile(`(?i)(authorization:[ \t]*)[^\r\n]+`)
+	emailPattern      = regexp.MustCompile(`[[:alnum:].+-]+@[[:alnum:].-]+\.[[:alpha:]]{2,}`)
+)
+
+// WithBuiltinRedactors adds the built-in redactors selected by set to both
+// attributes and the body. Like every other processor, they run in
+// registration order: after processors from options passed to New before
+// this one, and before those passed after it.
+func WithBuiltinRedactors(set RedactorSet) Option {
+	return optionFunc(func(cfg config) config {
+		for _, opt := range builtinRedactorOptions(set) {
+			cfg = opt.apply(cfg)
+		}
+		return cfg
+	})
+}
+
+func builtinRedactorOptions(set RedactorSet) []Option {
+	var patterns []*regexp.Regexp
+	if set&RedactCreditCards != 0 {
+		patterns = append(patterns, creditCardPattern)
+	}
+	if set&RedactEmails != 0 {
+		patterns = append(patterns, emailPattern)
+	}
+
+	var opts []Option
+	if len(patterns) > 0 {
+		opts = append(opts,
+			WithBodyRedactor(redactPatternsInBody(patterns)),
+			WithAttributeRedactor(redactPatternsInAttribute(patterns)),
+		)
+	}
+	if set&RedactAuthorizationHeaders != 0 {
+		opts = append(opts,
+			WithBodyRedactor(redactAuthHeaderInBody),
+			WithAttributeRedactor(redactAuthHeaderInAttribute),
+		)
+	}
+	return opts
+}
+
+func redactPatternsInBody(patterns []*regexp.Regexp) func(log.Value) log.Value {
+	return func(v log.Value) log.Value {
+		if v.Kind() != log.KindString {
+			return v
+		}
+		return log.StringValue(redactPatterns(v.AsString(), patterns))
+	}
+}
+
+func redactPatternsInAttribute(patterns []*regexp.Regexp) func(log.KeyValue) (log.KeyValue, bool) {
+	return func(kv log.KeyValue) (log.KeyValue, bool) {
+		if kv.Value.Kind() == log.KindString {
+			kv.Value = log.StringValue(redactPatterns(kv.Value.AsString(), patterns))
+		}
+		return kv, true
+	}
+}
+
+func redactPatterns(s string, patterns []*regexp.Regexp) string {
+	for _, p := range patterns {
+		s = p.ReplaceAllString(s, redactedPlaceholder)
+	}
+	return s
+}
+
+func redactAuthHeaderInBody(v log.Value) log.Value {
+	if v.Kind() != log.KindString {
+		return v
+	}
+	return log.StringValue(redactAuthHeader(v.AsString()))
+}
+
+func redactAuthHeaderInAttribute(kv log.KeyValue) (log.KeyValue, bool) {
+	if strings.EqualFold(kv.Key, "authorization") && kv.Value.Kind() == log.KindString {
+		kv.Value = log.StringValue(redactedPlaceholder)
+		return kv, true
+	}
+	if kv.Value.Kind() == log.KindString {
+		kv.Value = log.StringValue(redactAuthHeader(kv.Value.AsString()))
+	}
+	return kv, true
+}
+
+func redactAuthHeader(s string) string {
+	return authHeaderPattern.ReplaceAllString(s, "${1}"+redactedPlaceholder)
+}
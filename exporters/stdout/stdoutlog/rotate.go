@@ -0,0 +1,245 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RotateConfig configures the io.Writer returned by WithRotatingFile.
+type RotateConfig struct {
+	// Filename is the file to write logs to. Backups are created alongside
+	// it using its name and extension.
+	Filename string
+
+	// MaxSizeBytes is the size a file may reach before it is rotated. A
+	// value of 0 disables size-based rotation.
+	MaxSizeBytes int64
+
+	// MaxAge is how long a rotated backup is kept before being removed. A
+	// zero value disables age-based cleanup.
+	MaxAge time.Duration
+
+	// MaxBackups is the number of rotated backups to retain. A value of 0
+	// keeps all backups.
+	MaxBackups int
+
+	// Compress gzip-compresses rotated backups in the background.
+	Compress bool
+
+	// LocalTime uses the local system time, rather than UTC, to timestamp
+	// backups.
+	LocalTime bool
+}
+
+// WithRotatingFile returns a WithWriter Option backed by a file that rotates
+// according to cfg. Rotation is safe for the concurrent Export calls the
+// Exporter may make: renaming and reopening the file is guarded by a mutex,
+// the file is fsynced before it is closed, and compression of a rotated
+// backup runs in a background goroutine that Exporter.Shutdown joins.
+func WithRotatingFile(cfg RotateConfig) Option {
+	return WithWriter(newRotatingFile(cfg))
+}
+
+// rotatingFile is an io.Writer that rotates the underlying file by size
+// and/or age, optionally gzip-compressing rotated backups.
+type rotatingFile struct {
+	cfg RotateConfig
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+
+	compressWG sync.WaitGroup
+}
+
+func newRotatingFile(cfg RotateConfig) *rotatingFile {
+	return &rotatingFile{cfg: cfg}
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		if err := r.openExistingOrNew(); err != nil {
+			return 0, err
+		}
+	} else if r.cfg.MaxSizeBytes > 0 && r.size+int64(len(p)) > r.cfg.MaxSizeBytes {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	} else if r.cfg.MaxAge > 0 && r.now().Sub(r.openedAt) > r.cfg.MaxAge {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close flushes and closes the current file. It is called from
+// Exporter.Shutdown via the io.Closer the writer also implements.
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.compressWG.Wait()
+
+	if r.file == nil {
+		return nil
+	}
+	if err := r.file.Sync(); err != nil {
+		r.file.Close()
+		return err
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+func (r *rotatingFile) now() time.Time {
+	if r.cfg.LocalTime {
+		return time.Now()
+	}
+	return time.Now().UTC()
+}
+
+func (r *rotatingFile) openExistingOrNew() error {
+	f, err := os.OpenFile(r.cfg.Filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("stdoutlog: open log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("stdoutlog: stat log file: %w", err)
+	}
+	r.file = f
+	r.size = info.Size()
+	r.openedAt = r.now()
+	return nil
+}
+
+// rotate must be called with r.mu held.
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Sync(); err != nil {
+		return fmt.Errorf("stdoutlog: sync log file: %w", err)
+	}
+	if err := r.file.Close(); err != nil {
+		return fmt.Errorf("stdoutlog: close log file: %w", err)
+	}
+
+	backup := r.backupName()
+	if err := os.Rename(r.cfg.Filename, backup); err != nil {
+		return fmt.Errorf("stdoutlog: rotate log file: %w", err)
+	}
+
+	if r.cfg.Compress {
+		r.compressWG.Add(1)
+		go func() {
+			defer r.compressWG.Done()
+			compressFile(backup)
+		}()
+	}
+
+	r.pruneBackups()
+
+	return r.openExistingOrNew()
+}
+
+func (r *rotatingFile) backupName() string {
+	dir := filepath.Dir(r.cfg.Filename)
+	ext := filepath.Ext(r.cfg.Filename)
+	base := filepath.Base(r.cfg.Filename[:len(r.cfg.Filename)-len(ext)])
+	timestamp := r.now().Format("2006-01-02T15-04-05.000")
+	return filepath.Join(dir, fmt.Sprintf("%s-%s%s", base, timestamp, ext))
+}
+
+// pruneBackups removes backups older than MaxAge and, once MaxBackups is
+// exceeded, the oldest remaining ones. It must be called with r.mu held.
+func (r *rotatingFile) pruneBackups() {
+	if r.cfg.MaxAge <= 0 && r.cfg.MaxBackups <= 0 {
+		return
+	}
+
+	dir := filepath.Dir(r.cfg.Filename)
+	ext := filepath.Ext(r.cfg.Filename)
+	base := filepath.Base(r.cfg.Filename[:len(r.cfg.Filename)-len(ext)])
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	var backups []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		name := e.Name()
+		if filepath.Base(name) == filepath.Base(r.cfg.Filename) {
+			continue
+		}
+		if len(name) >= len(base) && name[:len(base)] == base {
+			backups = append(backups, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(backups)
+
+	if r.cfg.MaxAge > 0 {
+		cutoff := r.now().Add(-r.cfg.MaxAge)
+		kept := backups[:0]
+		for _, b := range backups {
+			info, err := os.Stat(b)
+			if err == nil && info.ModTime().Before(cutoff) {
+				os.Remove(b)
+				continue
+			}
+			kept = append(kept, b)
+		}
+		backups = kept
+	}
+
+	if r.cfg.MaxBackups > 0 && len(backups) > r.cfg.MaxBackups {
+		for _, b := range backups[:len(backups)-r.cfg.MaxBackups] {
+			os.Remove(b)
+		}
+	}
+}
+
+func compressFile(name string) {
+	src, err := os.Open(name)
+	if err != nil {
+		return
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(name+".gz", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return
+	}
+	defer dst.Close()
+
+	gw := gzip.NewWriter(dst)
+	if _, err := io.Copy(gw, src); err != nil {
+		gw.Close()
+		return
+	}
+	if err := gw.Close(); err != nil {
+		return
+	}
+
+	os.Remove(name)
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import sdklog "go.opentelemetry.io/otel/sdk/log"
+
+// Sink receives exported log records directly, bypassing Writer
+// serialization. It is implemented by the adapters returned from
+// WithSlogHandler and WithZapCore, and by WithLogrusLogger in the
+// go.opentelemetry.io/otel/exporters/stdout/stdoutlog/logrus sub-package,
+// which is kept separate so this package does not pull in logrus.
+type Sink interface {
+	Emit(record sdklog.Record) error
+}
+
+// WithSink configures the Exporter to emit records to s instead of
+// serializing them onto a Writer. WithWriter, WithPrettyPrint,
+// WithoutTimestamps, and WithEncoding have no effect when a Sink is
+// configured.
+func WithSink(s Sink) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.Sink = s
+		return cfg
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type capturingSlogHandler struct {
+	records []slog.Record
+}
+
+func (h *capturingSlogHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *capturingSlogHandler) Handle(_ context.Context, r slog.Record) error {
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *capturingSlogHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *capturingSlogHandler) WithGroup(string) slog.Handler      { return h }
+
+func TestSlogSinkEmit(t *testing.T) {
+	handler := &capturingSlogHandler{}
+	exporter, err := New(WithSlogHandler(handler))
+	require.NoError(t, err)
+
+	record := getRecord(time.Now())
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	require.Len(t, handler.records, 1)
+	assert.Equal(t, slog.LevelInfo, handler.records[0].Level)
+	assert.Equal(t, "test", handler.records[0].Message)
+}
+
+func TestZapSinkEmit(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	exporter, err := New(WithZapCore(core))
+	require.NoError(t, err)
+
+	record := sdklog.Record{}
+	record.SetSeverity(log.SeverityInfo1)
+	record.SetBody(log.StringValue("info entry"))
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{record}))
+
+	dropped := sdklog.Record{}
+	dropped.SetSeverity(log.SeverityDebug1)
+	dropped.SetBody(log.StringValue("debug entry"))
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{dropped}))
+
+	entries := logs.All()
+	require.Len(t, entries, 1, "the debug entry should have been dropped by Check")
+	assert.Equal(t, "info entry", entries[0].Message)
+}
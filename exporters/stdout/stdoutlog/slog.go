@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// WithSlogHandler configures the Exporter to emit records to handler instead
+// of serializing them onto a Writer. WithWriter, WithPrettyPrint,
+// WithoutTimestamps, and WithEncoding have no effect when a sink is
+// configured.
+func WithSlogHandler(handler slog.Handler) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.Sink = &slogSink{handler: handler}
+		return cfg
+	})
+}
+
+type slogSink struct {
+	handler slog.Handler
+}
+
+func (s *slogSink) Emit(record sdklog.Record) error {
+	r := slog.NewRecord(record.Timestamp(), slogLevel(record.Severity()), record.Body().AsString(), 0)
+
+	if traceID := record.TraceID(); traceID.IsValid() {
+		r.AddAttrs(slog.String("TraceID", traceID.String()))
+	}
+	if spanID := record.SpanID(); spanID.IsValid() {
+		r.AddAttrs(slog.String("SpanID", spanID.String()))
+	}
+	if flags := record.TraceFlags(); flags != 0 {
+		r.AddAttrs(slog.String("TraceFlags", flags.String()))
+	}
+
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		r.AddAttrs(slog.Any(kv.Key, nativeValue(kv.Value)))
+		return true
+	})
+
+	scope := record.InstrumentationScope()
+	r.AddAttrs(slog.Group("Scope", slog.String("Name", scope.Name), slog.String("Version", scope.Version)))
+
+	if res := record.Resource(); res.Len() > 0 {
+		iter := res.Iter()
+		attrs := make([]any, 0, res.Len())
+		for iter.Next() {
+			kv := iter.Attribute()
+			attrs = append(attrs, slog.Any(string(kv.Key), kv.Value.AsInterface()))
+		}
+		r.AddAttrs(slog.Group("Resource", attrs...))
+	}
+
+	if !s.handler.Enabled(context.Background(), r.Level) {
+		return nil
+	}
+	return s.handler.Handle(context.Background(), r)
+}
+
+// slogLevel maps an OTel log.Severity to the nearest slog.Level.
+func slogLevel(sev log.Severity) slog.Level {
+	switch {
+	case sev >= log.SeverityFatal1:
+		return slog.Level(12)
+	case sev >= log.SeverityError1:
+		return slog.LevelError
+	case sev >= log.SeverityWarn1:
+		return slog.LevelWarn
+	case sev >= log.SeverityInfo1:
+		return slog.LevelInfo
+	default:
+		return slog.LevelDebug
+	}
+}
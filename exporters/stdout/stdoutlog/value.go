@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import "go.opentelemetry.io/otel/log"
+
+// nativeValue converts a log.Value into a plain Go value (bool, int64,
+// float64, string, []byte, []any, or map[string]any) so it can be handed to
+// a structured-logging sink's field API.
+func nativeValue(v log.Value) any {
+	switch v.Kind() {
+	case log.KindBool:
+		return v.AsBool()
+	case log.KindFloat64:
+		return v.AsFloat64()
+	case log.KindInt64:
+		return v.AsInt64()
+	case log.KindString:
+		return v.AsString()
+	case log.KindBytes:
+		return v.AsBytes()
+	case log.KindSlice:
+		s := v.AsSlice()
+		out := make([]any, len(s))
+		for i, e := range s {
+			out[i] = nativeValue(e)
+		}
+		return out
+	case log.KindMap:
+		m := v.AsMap()
+		out := make(map[string]any, len(m))
+		for _, kv := range m {
+			out[kv.Key] = nativeValue(kv.Value)
+		}
+		return out
+	case log.KindEmpty:
+		return nil
+	default:
+		return v.String()
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferedWriter(t *testing.T) {
+	var buf bytes.Buffer
+	bw := newBufferedWriter(&buf, 4096, 0)
+
+	n, err := bw.Write([]byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Empty(t, buf.String(), "write should stay buffered until Flush")
+
+	require.NoError(t, bw.Flush())
+	assert.Equal(t, "hello", buf.String())
+
+	_, err = bw.Write([]byte(" world"))
+	require.NoError(t, err)
+	require.NoError(t, bw.Close())
+	assert.Equal(t, "hello world", buf.String(), "Close should flush any remaining data")
+}
+
+func TestBufferedWriterFlushInterval(t *testing.T) {
+	var buf bytes.Buffer
+	var mu sync.Mutex
+	lw := &lockedWriter{w: &buf, mu: &mu}
+	bw := newBufferedWriter(lw, 4096, 10*time.Millisecond)
+	defer bw.Close()
+
+	_, err := bw.Write([]byte("ticked"))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return lw.String() == "ticked"
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestRotatingFileRotatesOnSize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.log")
+
+	rf := newRotatingFile(RotateConfig{Filename: path, MaxSizeBytes: 8})
+	defer rf.Close()
+
+	_, err := rf.Write([]byte("1234567890")) // exceeds MaxSizeBytes on its own
+	require.NoError(t, err)
+	_, err = rf.Write([]byte("more"))
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "expected the original file plus one rotated backup")
+}
+
+func TestRotatingFileCompress(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.log")
+
+	rf := newRotatingFile(RotateConfig{Filename: path, MaxSizeBytes: 1, Compress: true})
+
+	_, err := rf.Write([]byte("first"))
+	require.NoError(t, err)
+	_, err = rf.Write([]byte("second"))
+	require.NoError(t, err)
+	require.NoError(t, rf.Close()) // joins the background compression goroutine
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	var gzipped string
+	for _, e := range entries {
+		if filepath.Ext(e.Name()) == ".gz" {
+			gzipped = filepath.Join(dir, e.Name())
+		}
+	}
+	require.NotEmpty(t, gzipped, "rotated backup should have been gzip-compressed")
+
+	f, err := os.Open(gzipped)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	defer gr.Close()
+
+	content, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(content))
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdoutlog // import "go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+
+import (
+	"math"
+
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// WithZapCore configures the Exporter to emit records to core instead of
+// serializing them onto a Writer. WithWriter, WithPrettyPrint,
+// WithoutTimestamps, and WithEncoding have no effect when a sink is
+// configured.
+func WithZapCore(core zapcore.Core) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.Sink = &zapSink{core: core}
+		return cfg
+	})
+}
+
+type zapSink struct {
+	core zapcore.Core
+}
+
+func (s *zapSink) Emit(record sdklog.Record) error {
+	level := zapLevel(record.Severity())
+
+	entry := zapcore.Entry{
+		Level:   level,
+		Time:    record.Timestamp(),
+		Message: record.Body().AsString(),
+	}
+
+	fields := make([]zapcore.Field, 0, record.AttributesLen()+4)
+
+	if traceID := record.TraceID(); traceID.IsValid() {
+		fields = append(fields, zapcore.Field{Key: "TraceID", Type: zapcore.StringType, String: traceID.String()})
+	}
+	if spanID := record.SpanID(); spanID.IsValid() {
+		fields = append(fields, zapcore.Field{Key: "SpanID", Type: zapcore.StringType, String: spanID.String()})
+	}
+	if flags := record.TraceFlags(); flags != 0 {
+		fields = append(fields, zapcore.Field{Key: "TraceFlags", Type: zapcore.StringType, String: flags.String()})
+	}
+
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		fields = append(fields, zapField(kv.Key, kv.Value))
+		return true
+	})
+
+	scope := record.InstrumentationScope()
+	fields = append(fields, zapcore.Field{
+		Key: "Scope", Type: zapcore.ObjectMarshalerType,
+		Interface: zapObjectMap(map[string]any{"Name": scope.Name, "Version": scope.Version}),
+	})
+
+	if res := record.Resource(); res.Len() > 0 {
+		attrs := make(map[string]any, res.Len())
+		iter := res.Iter()
+		for iter.Next() {
+			kv := iter.Attribute()
+			attrs[string(kv.Key)] = kv.Value.AsInterface()
+		}
+		fields = append(fields, zapcore.Field{Key: "Resource", Type: zapcore.ObjectMarshalerType, Interface: zapObjectMap(attrs)})
+	}
+
+	// Check reports whether entry should be logged at all, applying core's
+	// level enabler and any wrapping (e.g. sampling). A nil result means
+	// "don't log this" and must not fall through to an unconditional Write.
+	if checked := s.core.Check(entry, nil); checked != nil {
+		checked.Write(fields...)
+	}
+	return nil
+}
+
+// zapField converts a log.KeyValue into a zapcore.Field, flattening
+// slice/map values into zap's array/object encoders.
+func zapField(key string, v log.Value) zapcore.Field {
+	switch v.Kind() {
+	case log.KindBool:
+		return zapcore.Field{Key: key, Type: zapcore.BoolType, Integer: boolToInt64(v.AsBool())}
+	case log.KindFloat64:
+		return zapcore.Field{Key: key, Type: zapcore.Float64Type, Integer: int64(math.Float64bits(v.AsFloat64()))}
+	case log.KindInt64:
+		return zapcore.Field{Key: key, Type: zapcore.Int64Type, Integer: v.AsInt64()}
+	case log.KindString:
+		return zapcore.Field{Key: key, Type: zapcore.StringType, String: v.AsString()}
+	case log.KindBytes:
+		return zapcore.Field{Key: key, Type: zapcore.BinaryType, Interface: v.AsBytes()}
+	default:
+		return zapcore.Field{Key: key, Type: zapcore.ReflectType, Interface: nativeValue(v)}
+	}
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// zapObjectMap lets a plain map satisfy zapcore.ObjectMarshaler so it can be
+// attached as a nested group field.
+type zapObjectMap map[string]any
+
+func (m zapObjectMap) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddReflected(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// zapLevel maps an OTel log.Severity to the nearest zapcore.Level.
+func zapLevel(sev log.Severity) zapcore.Level {
+	switch {
+	case sev >= log.SeverityFatal1:
+		return zapcore.FatalLevel
+	case sev >= log.SeverityError1:
+		return zapcore.ErrorLevel
+	case sev >= log.SeverityWarn1:
+		return zapcore.WarnLevel
+	case sev >= log.SeverityInfo1:
+		return zapcore.InfoLevel
+	default:
+		return zapcore.DebugLevel
+	}
+}